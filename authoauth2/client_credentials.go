@@ -0,0 +1,120 @@
+package authoauth2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HTTPClient is the subset of *http.Client that the token sources need,
+// so callers can inject their own (e.g. for timeouts or instrumentation).
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// clientCredentialsTokenSource is an appsync.AuthTokenGetter that fetches
+// and caches tokens using the OAuth2 client-credentials grant.
+type clientCredentialsTokenSource struct {
+	mu         sync.Mutex
+	config     Config
+	httpClient HTTPClient
+	expirySkew time.Duration
+	current    token
+}
+
+// NewClientCredentialsTokenSource returns an appsync.AuthTokenGetter that
+// fetches tokens from cfg.TokenURL using the OAuth2 client-credentials
+// grant, caching the result and refreshing it DefaultExpirySkew before it
+// expires. The returned value can be passed directly to
+// appsync.NewClient(..., appsync.WithAuthTokenGetter(ts)).
+func NewClientCredentialsTokenSource(cfg Config, httpClient HTTPClient) *clientCredentialsTokenSource {
+	return &clientCredentialsTokenSource{
+		config:     cfg,
+		httpClient: httpClient,
+		expirySkew: DefaultExpirySkew,
+	}
+}
+
+// GetAuthToken returns a valid "Authorization" header value, fetching a
+// new token if none is cached or the cached one is due to expire.
+func (s *clientCredentialsTokenSource) GetAuthToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current.AccessToken == "" || s.current.expired(s.expirySkew) {
+		t, err := s.fetchToken()
+		if err != nil {
+			return "", err
+		}
+		s.current = t
+	}
+
+	return s.current.authHeader(), nil
+}
+
+func (s *clientCredentialsTokenSource) fetchToken() (token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", s.config.ClientID)
+	form.Set("client_secret", s.config.ClientSecret)
+	if len(s.config.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.config.Scopes, " "))
+	}
+
+	req, err := http.NewRequest("POST", s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return token{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return token{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return token{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return token{}, fmt.Errorf("authoauth2: token endpoint returned %s: %s", resp.Status, body)
+	}
+
+	return parseTokenResponse(body)
+}
+
+type tokenResponse struct {
+	AccessToken string      `json:"access_token"`
+	TokenType   string      `json:"token_type"`
+	ExpiresIn   json.Number `json:"expires_in"`
+}
+
+func parseTokenResponse(body []byte) (token, error) {
+	var tr tokenResponse
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return token{}, err
+	}
+	if tr.AccessToken == "" {
+		return token{}, errors.New("authoauth2: token endpoint response is missing access_token")
+	}
+
+	t := token{AccessToken: tr.AccessToken, TokenType: tr.TokenType}
+	if tr.ExpiresIn != "" {
+		seconds, err := strconv.Atoi(tr.ExpiresIn.String())
+		if err != nil {
+			return token{}, fmt.Errorf("authoauth2: invalid expires_in: %w", err)
+		}
+		t.ExpiresAt = time.Now().Add(time.Duration(seconds) * time.Second)
+	}
+
+	return t, nil
+}
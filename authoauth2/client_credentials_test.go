@@ -0,0 +1,75 @@
+package authoauth2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientCredentialsTokenSourceGetAuthToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"tok-1","token_type":"Bearer","expires_in":"3600"}`))
+	}))
+	defer server.Close()
+
+	ts := NewClientCredentialsTokenSource(Config{ClientID: "id", ClientSecret: "secret", TokenURL: server.URL}, server.Client())
+
+	token, err := ts.GetAuthToken()
+	if err != nil {
+		t.Fatalf("GetAuthToken() error = %v, want nil", err)
+	}
+	if token != "Bearer tok-1" {
+		t.Fatalf("GetAuthToken() = %q, want %q", token, "Bearer tok-1")
+	}
+}
+
+func TestClientCredentialsTokenSourceMissingAccessToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+
+	ts := NewClientCredentialsTokenSource(Config{TokenURL: server.URL}, server.Client())
+
+	if _, err := ts.GetAuthToken(); err == nil {
+		t.Fatal("GetAuthToken() error = nil, want an error for a missing access_token")
+	}
+}
+
+func TestClientCredentialsTokenSourceNonOKStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid_client"}`))
+	}))
+	defer server.Close()
+
+	ts := NewClientCredentialsTokenSource(Config{TokenURL: server.URL}, server.Client())
+
+	if _, err := ts.GetAuthToken(); err == nil {
+		t.Fatal("GetAuthToken() error = nil, want an error for a non-200 response")
+	}
+}
+
+func TestClientCredentialsTokenSourceCachesUntilSkew(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"tok-1","expires_in":"3600"}`))
+	}))
+	defer server.Close()
+
+	ts := NewClientCredentialsTokenSource(Config{TokenURL: server.URL}, server.Client())
+
+	if _, err := ts.GetAuthToken(); err != nil {
+		t.Fatalf("GetAuthToken() error = %v, want nil", err)
+	}
+	if _, err := ts.GetAuthToken(); err != nil {
+		t.Fatalf("GetAuthToken() error = %v, want nil", err)
+	}
+	if calls != 1 {
+		t.Fatalf("token endpoint called %d times, want 1 (cached)", calls)
+	}
+}
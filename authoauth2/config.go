@@ -0,0 +1,38 @@
+// Package authoauth2 provides AuthTokenGetter implementations for AppSync
+// APIs that are protected by an OIDC/OAuth2 Lambda authorizer, so callers
+// don't have to wire up their own token refresh plumbing.
+package authoauth2
+
+import "time"
+
+// Config holds the OAuth2 client-credentials settings needed to fetch
+// tokens from an authorization server's token endpoint.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	TokenURL     string
+	Scopes       []string
+}
+
+// DefaultExpirySkew is how long before the reported expiry a token is
+// considered stale and due for refresh.
+const DefaultExpirySkew = 30 * time.Second
+
+// token is the parsed token endpoint response, along with the local
+// wall-clock time at which it should be considered expired.
+type token struct {
+	AccessToken string
+	TokenType   string
+	ExpiresAt   time.Time
+}
+
+func (t token) authHeader() string {
+	if t.TokenType == "" {
+		return t.AccessToken
+	}
+	return t.TokenType + " " + t.AccessToken
+}
+
+func (t token) expired(skew time.Duration) bool {
+	return !t.ExpiresAt.IsZero() && time.Now().Add(skew).After(t.ExpiresAt)
+}
@@ -0,0 +1,120 @@
+package authoauth2
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// refreshTokenSource is an appsync.AuthTokenGetter for the authorization
+// code grant that keeps itself alive by exchanging a refresh token, and
+// persists that refresh token via a TokenStore so it survives restarts.
+type refreshTokenSource struct {
+	mu         sync.Mutex
+	config     Config
+	httpClient HTTPClient
+	store      TokenStore
+	expirySkew time.Duration
+	current    token
+	refresh    string
+}
+
+// NewRefreshTokenSource returns an appsync.AuthTokenGetter that exchanges
+// the refresh token loaded from store for access tokens at cfg.TokenURL,
+// persisting the (possibly rotated) refresh token back to store after
+// every exchange.
+func NewRefreshTokenSource(cfg Config, httpClient HTTPClient, store TokenStore) (*refreshTokenSource, error) {
+	saved, err := store.Load()
+	if err != nil {
+		return nil, fmt.Errorf("authoauth2: loading stored token: %w", err)
+	}
+	if saved.RefreshToken == "" {
+		return nil, fmt.Errorf("authoauth2: token store has no refresh token")
+	}
+
+	return &refreshTokenSource{
+		config:     cfg,
+		httpClient: httpClient,
+		store:      store,
+		expirySkew: DefaultExpirySkew,
+		refresh:    saved.RefreshToken,
+	}, nil
+}
+
+// GetAuthToken returns a valid "Authorization" header value, exchanging
+// the refresh token for a new access token if none is cached or the
+// cached one is due to expire.
+func (s *refreshTokenSource) GetAuthToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current.AccessToken == "" || s.current.expired(s.expirySkew) {
+		if err := s.refreshAccessToken(); err != nil {
+			return "", err
+		}
+	}
+
+	return s.current.authHeader(), nil
+}
+
+func (s *refreshTokenSource) refreshAccessToken() error {
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", s.refresh)
+	form.Set("client_id", s.config.ClientID)
+	form.Set("client_secret", s.config.ClientSecret)
+
+	req, err := http.NewRequest("POST", s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("authoauth2: token endpoint returned %s: %s", resp.Status, body)
+	}
+
+	var tr struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return err
+	}
+
+	t, err := parseTokenResponse(body)
+	if err != nil {
+		return err
+	}
+
+	refresh := s.refresh
+	if tr.RefreshToken != "" {
+		refresh = tr.RefreshToken
+	}
+
+	if err := s.store.Save(Token{
+		AccessToken:  t.AccessToken,
+		RefreshToken: refresh,
+		ExpiresAt:    t.ExpiresAt.Unix(),
+	}); err != nil {
+		return err
+	}
+
+	s.current = t
+	s.refresh = refresh
+	return nil
+}
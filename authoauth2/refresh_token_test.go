@@ -0,0 +1,111 @@
+package authoauth2
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeTokenStore struct {
+	saved Token
+}
+
+func (s *fakeTokenStore) Load() (Token, error) {
+	return Token{RefreshToken: "initial-refresh"}, nil
+}
+
+func (s *fakeTokenStore) Save(t Token) error {
+	s.saved = t
+	return nil
+}
+
+func TestRefreshTokenSourceGetAuthToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"tok-1","refresh_token":"refresh-2","expires_in":"3600"}`))
+	}))
+	defer server.Close()
+
+	store := &fakeTokenStore{}
+	ts, err := NewRefreshTokenSource(Config{TokenURL: server.URL}, server.Client(), store)
+	if err != nil {
+		t.Fatalf("NewRefreshTokenSource() error = %v, want nil", err)
+	}
+
+	token, err := ts.GetAuthToken()
+	if err != nil {
+		t.Fatalf("GetAuthToken() error = %v, want nil", err)
+	}
+	if token != "tok-1" {
+		t.Fatalf("GetAuthToken() = %q, want %q", token, "tok-1")
+	}
+	if store.saved.RefreshToken != "refresh-2" {
+		t.Fatalf("stored refresh token = %q, want rotated value %q", store.saved.RefreshToken, "refresh-2")
+	}
+}
+
+func TestRefreshTokenSourceMissingAccessToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"refresh_token":"refresh-2"}`))
+	}))
+	defer server.Close()
+
+	store := &fakeTokenStore{}
+	ts, err := NewRefreshTokenSource(Config{TokenURL: server.URL}, server.Client(), store)
+	if err != nil {
+		t.Fatalf("NewRefreshTokenSource() error = %v, want nil", err)
+	}
+
+	if _, err := ts.GetAuthToken(); err == nil {
+		t.Fatal("GetAuthToken() error = nil, want an error for a missing access_token")
+	}
+}
+
+type failingTokenStore struct {
+	err error
+}
+
+func (s *failingTokenStore) Load() (Token, error) {
+	return Token{RefreshToken: "initial-refresh"}, nil
+}
+
+func (s *failingTokenStore) Save(Token) error { return s.err }
+
+func TestRefreshTokenSourceDoesNotUpdateStateWhenStoreSaveFails(t *testing.T) {
+	saveErr := errors.New("store unavailable")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"tok-1","refresh_token":"refresh-2","expires_in":"3600"}`))
+	}))
+	defer server.Close()
+
+	store := &failingTokenStore{err: saveErr}
+	ts, err := NewRefreshTokenSource(Config{TokenURL: server.URL}, server.Client(), store)
+	if err != nil {
+		t.Fatalf("NewRefreshTokenSource() error = %v, want nil", err)
+	}
+
+	if _, err := ts.GetAuthToken(); !errors.Is(err, saveErr) {
+		t.Fatalf("GetAuthToken() error = %v, want %v", err, saveErr)
+	}
+	if ts.current.AccessToken != "" {
+		t.Fatalf("current access token = %q, want empty since the store Save never succeeded", ts.current.AccessToken)
+	}
+	if ts.refresh != "initial-refresh" {
+		t.Fatalf("refresh token = %q, want the original %q to be left untouched", ts.refresh, "initial-refresh")
+	}
+}
+
+func TestNewRefreshTokenSourceRequiresStoredRefreshToken(t *testing.T) {
+	store := &emptyTokenStore{}
+	if _, err := NewRefreshTokenSource(Config{}, http.DefaultClient, store); err == nil {
+		t.Fatal("NewRefreshTokenSource() error = nil, want an error when the store has no refresh token")
+	}
+}
+
+type emptyTokenStore struct{}
+
+func (emptyTokenStore) Load() (Token, error) { return Token{}, nil }
+func (emptyTokenStore) Save(Token) error     { return nil }
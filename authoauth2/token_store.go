@@ -0,0 +1,16 @@
+package authoauth2
+
+// Token is the persisted state of a refresh-token-based session.
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    int64 // unix seconds, 0 if unknown
+}
+
+// TokenStore persists a Token across process restarts, e.g. to disk or a
+// database, so a long-running client doesn't have to re-run the
+// authorization code flow every time it starts up.
+type TokenStore interface {
+	Load() (Token, error)
+	Save(Token) error
+}
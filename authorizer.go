@@ -0,0 +1,120 @@
+package appsync
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+// Authorizer signs or annotates an outgoing request for one of AppSync's
+// auth modes (IAM, API key, Cognito User Pools, or a Lambda authorizer)
+// by applying whatever headers it needs to header.
+type Authorizer interface {
+	Apply(ctx context.Context, request graphql.PostRequest, header http.Header) error
+}
+
+// WithAuthorizer adds a to the Client's list of authorizers. Authorizers
+// are applied in the order they're configured, after any IAM/bearer auth
+// set up via WithIAMAuthorization/WithAuthTokenGetter, so e.g. IAM
+// signing plus a subscriber-id combination keeps working unchanged.
+func WithAuthorizer(a Authorizer) ClientOption {
+	return func(c *Client) {
+		c.authorizers = append(c.authorizers, a)
+	}
+}
+
+// iamAuthorizer adapts the Client's existing IAM signing path to the
+// Authorizer interface.
+type iamAuthorizer struct {
+	client *Client
+}
+
+func (a *iamAuthorizer) Apply(_ context.Context, request graphql.PostRequest, header http.Header) error {
+	if a.client.iamAuth == nil {
+		return nil
+	}
+
+	h, err := a.client.signRequest(request)
+	if err != nil {
+		return err
+	}
+	for k, v := range h {
+		header[k] = v
+	}
+	return nil
+}
+
+// bearerAuthorizer adapts the Client's existing AuthTokenGetter path,
+// including its re-auth and refresh-policy handling, to the Authorizer
+// interface.
+type bearerAuthorizer struct {
+	client *Client
+}
+
+func (a *bearerAuthorizer) Apply(ctx context.Context, _ graphql.PostRequest, header http.Header) error {
+	c := a.client
+	auth := c.getAuth()
+	if auth == nil {
+		return nil
+	}
+
+	if c.noteAuthUse() {
+		if err := c.forceReAuth(ctx); err != nil {
+			return err
+		}
+	}
+
+	token, err := auth.GetAuthToken()
+	if err == ErrTokenExpired {
+		reAuthed, reAuthErr := c.reAuth(ctx)
+		switch {
+		case reAuthed && reAuthErr == nil:
+			token, err = c.getAuth().GetAuthToken()
+		case reAuthErr != nil:
+			if fallback := c.fallbackAuth(); fallback != nil {
+				token, err = fallback.GetAuthToken()
+			} else {
+				err = fmt.Errorf("appsync: re-auth failed: %w", reAuthErr)
+			}
+		default:
+			if fallback := c.fallbackAuth(); fallback != nil {
+				token, err = fallback.GetAuthToken()
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	header.Set("Authorization", token)
+	return nil
+}
+
+// ReAuth lets bearerAuthorizer participate in Client.reAuth's sweep over
+// c.authorizers, delegating to the legacy auth field's ReAuther if it has
+// one.
+func (a *bearerAuthorizer) ReAuth(ctx context.Context) error {
+	reAuther, ok := a.client.getAuth().(ReAuther)
+	if !ok {
+		return errReAuthNotSupported
+	}
+	return reAuther.ReAuth(ctx)
+}
+
+// defaultAuthorizers builds the Authorizer pair that implements the
+// legacy iamAuth/auth fields, so code configured purely via
+// WithIAMAuthorization/WithAuthTokenGetter keeps working once
+// createHeader starts iterating c.authorizers. Both are always included,
+// regardless of whether c.iamAuth/c.auth are set yet: each checks its own
+// field dynamically on every Apply call (iamAuthorizer reads c.iamAuth,
+// bearerAuthorizer calls getAuth), so a field set later via UpdateAuth
+// (which only ever runs after NewClient has returned) still takes
+// effect instead of being silently ignored.
+func (c *Client) defaultAuthorizers() []Authorizer {
+	return []Authorizer{
+		&iamAuthorizer{client: c},
+		&bearerAuthorizer{client: c},
+	}
+}
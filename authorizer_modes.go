@@ -0,0 +1,100 @@
+package appsync
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+// APIKeyAuthorizer implements the "API_KEY" AppSync auth mode by setting
+// the x-api-key header.
+type APIKeyAuthorizer struct {
+	APIKey string
+}
+
+// Apply sets the x-api-key header.
+func (a APIKeyAuthorizer) Apply(_ context.Context, _ graphql.PostRequest, header http.Header) error {
+	header.Set("x-api-key", a.APIKey)
+	return nil
+}
+
+// CognitoUserPoolAuthorizer implements the "AMAZON_COGNITO_USER_POOLS"
+// AppSync auth mode: it fetches a token from TokenSource and checks that
+// it's a Cognito ID token (rather than an access token) before sending
+// it, since AppSync requires the former.
+type CognitoUserPoolAuthorizer struct {
+	TokenSource AuthTokenGetter
+}
+
+// Apply fetches the token and sets the Authorization header, rejecting
+// tokens that aren't Cognito ID tokens.
+func (a CognitoUserPoolAuthorizer) Apply(_ context.Context, _ graphql.PostRequest, header http.Header) error {
+	token, err := a.TokenSource.GetAuthToken()
+	if err != nil {
+		return err
+	}
+
+	claims, ok := decodeJWTClaims(token)
+	if !ok {
+		return fmt.Errorf("appsync: CognitoUserPoolAuthorizer: token is not a JWT")
+	}
+	if tokenUse, _ := claims["token_use"].(string); tokenUse != "id" {
+		return fmt.Errorf("appsync: CognitoUserPoolAuthorizer: expected a Cognito ID token, got token_use=%q", tokenUse)
+	}
+
+	header.Set("Authorization", token)
+	return nil
+}
+
+// ReAuth lets CognitoUserPoolAuthorizer participate in Client.reAuth's
+// sweep over the configured authorizers, delegating to TokenSource's
+// ReAuther if it has one.
+func (a CognitoUserPoolAuthorizer) ReAuth(ctx context.Context) error {
+	reAuther, ok := a.TokenSource.(ReAuther)
+	if !ok {
+		return errReAuthNotSupported
+	}
+	return reAuther.ReAuth(ctx)
+}
+
+// LambdaAuthorizer implements the "AWS_LAMBDA" AppSync auth mode by
+// delegating to an arbitrary function to produce whatever headers the
+// Lambda authorizer expects, e.g. an "Authorization" token it will
+// validate itself.
+type LambdaAuthorizer struct {
+	Headers func(ctx context.Context, request graphql.PostRequest) (http.Header, error)
+}
+
+// Apply merges the headers produced by Headers into header.
+func (a LambdaAuthorizer) Apply(ctx context.Context, request graphql.PostRequest, header http.Header) error {
+	produced, err := a.Headers(ctx, request)
+	if err != nil {
+		return err
+	}
+	for k, v := range produced {
+		header[k] = v
+	}
+	return nil
+}
+
+// PerOperationAuthorizer returns an Authorizer that picks a different
+// underlying Authorizer per request, e.g. to use API keys for public
+// queries and IAM for admin mutations on the same endpoint.
+// selectAuthorizer returning nil skips authorization for that request.
+func PerOperationAuthorizer(selectAuthorizer func(request graphql.PostRequest) Authorizer) Authorizer {
+	return perOperationAuthorizer{selectAuthorizer}
+}
+
+type perOperationAuthorizer struct {
+	selectAuthorizer func(request graphql.PostRequest) Authorizer
+}
+
+func (a perOperationAuthorizer) Apply(ctx context.Context, request graphql.PostRequest, header http.Header) error {
+	authorizer := a.selectAuthorizer(request)
+	if authorizer == nil {
+		return nil
+	}
+	return authorizer.Apply(ctx, request, header)
+}
@@ -0,0 +1,132 @@
+package appsync
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+func TestAPIKeyAuthorizerSetsHeader(t *testing.T) {
+	header := http.Header{}
+	a := APIKeyAuthorizer{APIKey: "da-key"}
+
+	if err := a.Apply(context.Background(), graphql.PostRequest{}, header); err != nil {
+		t.Fatalf("Apply() error = %v, want nil", err)
+	}
+	if got := header.Get("x-api-key"); got != "da-key" {
+		t.Fatalf("x-api-key header = %q, want %q", got, "da-key")
+	}
+}
+
+func TestCognitoUserPoolAuthorizerApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		tokenErr error
+		wantErr  bool
+	}{
+		{name: "valid ID token", token: cognitoIDToken("id")},
+		{name: "access token rejected", token: cognitoIDToken("access"), wantErr: true},
+		{name: "non-JWT token rejected", token: "not-a-jwt", wantErr: true},
+		{name: "token source error propagates", tokenErr: errors.New("token source down"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := &fakeCognitoTokenSource{token: tt.token}
+			a := CognitoUserPoolAuthorizer{TokenSource: tokenSourceWithErr{ts, tt.tokenErr}}
+			header := http.Header{}
+
+			err := a.Apply(context.Background(), graphql.PostRequest{}, header)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Apply() error = nil, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Apply() error = %v, want nil", err)
+			}
+			if got := header.Get("Authorization"); got != tt.token {
+				t.Fatalf("Authorization header = %q, want %q", got, tt.token)
+			}
+		})
+	}
+}
+
+// tokenSourceWithErr wraps an AuthTokenGetter, optionally injecting an
+// error from GetAuthToken, to exercise CognitoUserPoolAuthorizer's error
+// path without changing fakeCognitoTokenSource's own behavior.
+type tokenSourceWithErr struct {
+	AuthTokenGetter
+	err error
+}
+
+func (t tokenSourceWithErr) GetAuthToken() (string, error) {
+	if t.err != nil {
+		return "", t.err
+	}
+	return t.AuthTokenGetter.GetAuthToken()
+}
+
+func TestLambdaAuthorizerApply(t *testing.T) {
+	a := LambdaAuthorizer{
+		Headers: func(_ context.Context, _ graphql.PostRequest) (http.Header, error) {
+			h := http.Header{}
+			h.Set("Authorization", "lambda-token")
+			return h, nil
+		},
+	}
+	header := http.Header{}
+
+	if err := a.Apply(context.Background(), graphql.PostRequest{}, header); err != nil {
+		t.Fatalf("Apply() error = %v, want nil", err)
+	}
+	if got := header.Get("Authorization"); got != "lambda-token" {
+		t.Fatalf("Authorization header = %q, want %q", got, "lambda-token")
+	}
+}
+
+func TestLambdaAuthorizerApplyPropagatesError(t *testing.T) {
+	wantErr := errors.New("lambda authorizer failed")
+	a := LambdaAuthorizer{
+		Headers: func(_ context.Context, _ graphql.PostRequest) (http.Header, error) {
+			return nil, wantErr
+		},
+	}
+
+	if err := a.Apply(context.Background(), graphql.PostRequest{}, http.Header{}); !errors.Is(err, wantErr) {
+		t.Fatalf("Apply() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestPerOperationAuthorizerSelectsUnderlyingAuthorizer(t *testing.T) {
+	authorizer := PerOperationAuthorizer(func(_ graphql.PostRequest) Authorizer {
+		return APIKeyAuthorizer{APIKey: "selected-key"}
+	})
+	header := http.Header{}
+
+	if err := authorizer.Apply(context.Background(), graphql.PostRequest{}, header); err != nil {
+		t.Fatalf("Apply() error = %v, want nil", err)
+	}
+	if got := header.Get("x-api-key"); got != "selected-key" {
+		t.Fatalf("x-api-key header = %q, want %q", got, "selected-key")
+	}
+}
+
+func TestPerOperationAuthorizerSkipsWhenSelectorReturnsNil(t *testing.T) {
+	authorizer := PerOperationAuthorizer(func(_ graphql.PostRequest) Authorizer {
+		return nil
+	})
+	header := http.Header{}
+
+	if err := authorizer.Apply(context.Background(), graphql.PostRequest{}, header); err != nil {
+		t.Fatalf("Apply() error = %v, want nil", err)
+	}
+	if len(header) != 0 {
+		t.Fatalf("header = %v, want untouched when selector returns nil", header)
+	}
+}
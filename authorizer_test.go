@@ -0,0 +1,36 @@
+package appsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+type staticTokenGetter string
+
+func (s staticTokenGetter) GetAuthToken() (string, error) {
+	return string(s), nil
+}
+
+func TestCreateHeaderPicksUpAuthSetAfterConstruction(t *testing.T) {
+	c := NewClient(nil)
+
+	header, err := c.createHeader(context.Background(), graphql.PostRequest{})
+	if err != nil {
+		t.Fatalf("createHeader() error = %v, want nil", err)
+	}
+	if got := header.Get("Authorization"); got != "" {
+		t.Fatalf("Authorization header = %q before any auth is configured, want empty", got)
+	}
+
+	c.UpdateAuth(staticTokenGetter("first-token"))
+
+	header, err = c.createHeader(context.Background(), graphql.PostRequest{})
+	if err != nil {
+		t.Fatalf("createHeader() error = %v, want nil", err)
+	}
+	if got := header.Get("Authorization"); got != "first-token" {
+		t.Fatalf("Authorization header = %q, want %q", got, "first-token")
+	}
+}
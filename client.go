@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
@@ -20,18 +21,30 @@ type AuthTokenGetter interface {
 // Client is the AppSync GraphQL API client
 type Client struct {
 	sync.RWMutex
-	graphQLAPI   GraphQLClient
-	subscriberID string
-	iamAuth      *iamAuth
-	auth         AuthTokenGetter
+	graphQLAPI        GraphQLClient
+	subscriberID      string
+	iamAuth           *iamAuth
+	auth              AuthTokenGetter
+	maxReAuthAttempts int
+	reAuthBackoff     time.Duration
+
+	refreshPolicy      RefreshPolicy
+	authState          authState
+	previousAuth       AuthTokenGetter
+	previousValidUntil time.Time
+
+	middlewares        []Middleware
+	authorizers        []Authorizer
+	healthCheckRequest *graphql.PostRequest
 }
 
 // NewClient returns a Client instance.
 func NewClient(graphql GraphQLClient, opts ...ClientOption) *Client {
-	c := &Client{graphQLAPI: graphql}
+	c := &Client{graphQLAPI: graphql, maxReAuthAttempts: 1}
 	for _, opt := range opts {
 		opt(c)
 	}
+	c.authorizers = append(c.defaultAuthorizers(), c.authorizers...)
 	return c
 }
 
@@ -61,65 +74,131 @@ func (c *Client) signRequest(request graphql.PostRequest) (http.Header, error) {
 	return req.Header, nil
 }
 
-// Post is a synchronous AppSync GraphQL POST request.
+// Post is a synchronous AppSync GraphQL POST request. If the request is
+// rejected as unauthorized and the configured AuthTokenGetter supports
+// ReAuther, the token is refreshed and the request retried according to
+// the configured re-auth retry policy.
 func (c *Client) Post(request graphql.PostRequest) (*graphql.Response, error) {
 	defer c.sleepIfNeeded(request)
 
-	header, err := c.createHeader(request)
+	ctx := context.Background()
+	header, err := c.createHeader(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.graphQLAPI.Post(header, request)
+	handler := c.chain(func(_ context.Context, header http.Header, request graphql.PostRequest) (*graphql.Response, error) {
+		return c.graphQLAPI.Post(header, request)
+	})
+	return c.postWithReAuth(ctx, request, handler, header)
 }
 
-// PostAsync is an asynchronous AppSync GraphQL POST request.
+// PostAsync is an asynchronous AppSync GraphQL POST request. Header
+// creation (including signing/fetching an auth token) happens
+// synchronously, so a configuration or auth error is returned directly
+// rather than only surfacing via callback, and that same header is
+// reused for the first attempt; everything after that, including any
+// retry on an unauthorized response, runs on its own goroutine, and
+// callback is invoked exactly once with the final result. The returned
+// CancelFunc aborts the request.
 func (c *Client) PostAsync(request graphql.PostRequest, callback func(*graphql.Response, error)) (context.CancelFunc, error) {
-	header, err := c.createHeader(request)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	header, err := c.createHeader(ctx, request)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
-	cb := func(g *graphql.Response, err error) {
+	handler := c.chain(c.asyncHandler())
+	go func() {
+		resp, err := c.postWithReAuth(ctx, request, handler, header)
 		c.sleepIfNeeded(request)
-		callback(g, err)
+		callback(resp, err)
+	}()
+
+	return cancel, nil
+}
+
+// postWithReAuth runs handler using header for the first attempt,
+// retrying with a freshly built header once ReAuth has refreshed the
+// credentials if the response comes back unauthorized, up to
+// maxReAuthAttempts times.
+func (c *Client) postWithReAuth(ctx context.Context, request graphql.PostRequest, handler Handler, header http.Header) (*graphql.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			var err error
+			header, err = c.createHeader(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := handler(ctx, header, request)
+		if !isUnauthorized(resp, err) || attempt >= c.maxReAuthAttempts {
+			return resp, err
+		}
+
+		reAuthed, reAuthErr := c.reAuth(ctx)
+		if reAuthErr != nil {
+			return resp, fmt.Errorf("appsync: re-auth failed: %w", reAuthErr)
+		}
+		if !reAuthed {
+			return resp, err
+		}
+
+		if c.reAuthBackoff > 0 {
+			time.Sleep(c.reAuthBackoff)
+		}
 	}
+}
 
-	return c.graphQLAPI.PostAsync(header, request, cb)
+// asyncHandler adapts the callback-based GraphQLClient.PostAsync into a
+// Handler, so it can be wrapped by the same middleware chain as Post.
+func (c *Client) asyncHandler() Handler {
+	return func(ctx context.Context, header http.Header, request graphql.PostRequest) (*graphql.Response, error) {
+		type result struct {
+			resp *graphql.Response
+			err  error
+		}
+		resultCh := make(chan result, 1)
+
+		dispatchCancel, err := c.graphQLAPI.PostAsync(header, request, func(resp *graphql.Response, err error) {
+			resultCh <- result{resp, err}
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case res := <-resultCh:
+			return res.resp, res.err
+		case <-ctx.Done():
+			dispatchCancel()
+			return nil, ctx.Err()
+		}
+	}
 }
 
 // UpdateAuth lets the user update the tokens. This is necessary because the
-// refresh token will eventually expire.
+// refresh token will eventually expire. If a RefreshPolicy has been
+// configured via WithRefreshPolicy, it governs whether auth is actually
+// swapped in and for how long the previous auth remains usable.
 func (c *Client) UpdateAuth(auth AuthTokenGetter) {
-	c.Lock()
-	defer c.Unlock()
-
-	c.auth = auth
+	c.rotateAuth(auth)
 }
 
-func (c *Client) createHeader(request graphql.PostRequest) (http.Header, error) {
+func (c *Client) createHeader(ctx context.Context, request graphql.PostRequest) (http.Header, error) {
 	header := http.Header{}
 	subscriberID := c.getSubscriberID()
 	if request.IsSubscription() && len(subscriberID) > 0 {
 		header.Set("x-amz-subscriber-id", subscriberID)
 	}
 
-	if c.iamAuth != nil {
-		h, err := c.signRequest(request)
-		if err != nil {
-			return header, err
-		}
-		for k, v := range h {
-			header[k] = v
-		}
-	}
-
-	if c.auth != nil {
-		token, err := c.auth.GetAuthToken()
-		if err != nil {
+	for _, authorizer := range c.authorizers {
+		if err := authorizer.Apply(ctx, request, header); err != nil {
 			return header, err
 		}
-		header.Set("Authorization", token)
 	}
 
 	return header, nil
@@ -132,6 +211,15 @@ func (c *Client) getSubscriberID() string {
 	return c.subscriberID
 }
 
+// getAuth returns the currently configured AuthTokenGetter, guarding the
+// read against a concurrent UpdateAuth/rotateAuth.
+func (c *Client) getAuth() AuthTokenGetter {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.auth
+}
+
 func (c *Client) getIAMAuth() iamAuth {
 	c.RLock()
 	defer c.RUnlock()
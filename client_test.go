@@ -0,0 +1,85 @@
+package appsync
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+type fakeGraphQLClient struct {
+	responses []*graphql.Response
+	errs      []error
+	calls     int
+}
+
+func (f *fakeGraphQLClient) Post(header http.Header, request graphql.PostRequest) (*graphql.Response, error) {
+	i := f.calls
+	f.calls++
+	return f.responses[i], f.errs[i]
+}
+
+func (f *fakeGraphQLClient) PostAsync(header http.Header, request graphql.PostRequest, callback func(*graphql.Response, error)) (context.CancelFunc, error) {
+	resp, err := f.Post(header, request)
+	callback(resp, err)
+	return func() {}, nil
+}
+
+type fakeReAuthTokenGetter struct {
+	token      string
+	reAuthErr  error
+	reAuthCall int
+}
+
+func (f *fakeReAuthTokenGetter) GetAuthToken() (string, error) {
+	return f.token, nil
+}
+
+func (f *fakeReAuthTokenGetter) ReAuth(ctx context.Context) error {
+	f.reAuthCall++
+	return f.reAuthErr
+}
+
+func unauthorizedResponse() *graphql.Response {
+	return &graphql.Response{Errors: []graphql.Error{{ErrorType: "UnauthorizedException"}}}
+}
+
+func TestPostRetriesOnceAfterSuccessfulReAuth(t *testing.T) {
+	auth := &fakeReAuthTokenGetter{token: "stale"}
+	graphQLAPI := &fakeGraphQLClient{
+		responses: []*graphql.Response{unauthorizedResponse(), {}},
+		errs:      []error{nil, nil},
+	}
+	c := NewClient(graphQLAPI)
+	c.auth = auth
+	c.authorizers = c.defaultAuthorizers()
+
+	if _, err := c.Post(graphql.PostRequest{}); err != nil {
+		t.Fatalf("Post() error = %v, want nil", err)
+	}
+	if auth.reAuthCall != 1 {
+		t.Fatalf("ReAuth called %d times, want 1", auth.reAuthCall)
+	}
+	if graphQLAPI.calls != 2 {
+		t.Fatalf("Post dispatched %d times, want 2", graphQLAPI.calls)
+	}
+}
+
+func TestPostPropagatesReAuthError(t *testing.T) {
+	wantErr := errors.New("refresh token expired")
+	auth := &fakeReAuthTokenGetter{token: "stale", reAuthErr: wantErr}
+	graphQLAPI := &fakeGraphQLClient{
+		responses: []*graphql.Response{unauthorizedResponse()},
+		errs:      []error{nil},
+	}
+	c := NewClient(graphQLAPI)
+	c.auth = auth
+	c.authorizers = c.defaultAuthorizers()
+
+	_, err := c.Post(graphql.PostRequest{})
+	if err == nil || !errors.Is(err, wantErr) {
+		t.Fatalf("Post() error = %v, want it to wrap %v", err, wantErr)
+	}
+}
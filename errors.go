@@ -0,0 +1,9 @@
+package appsync
+
+import "errors"
+
+// ErrTokenExpired is returned by an AuthTokenGetter (or encountered in an
+// AppSync response) to signal that the current auth token is no longer
+// valid and a re-authentication is required before the request can
+// succeed.
+var ErrTokenExpired = errors.New("appsync: auth token expired")
@@ -0,0 +1,40 @@
+package appsync
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+// WithHealthCheckRequest configures the GraphQL document HealthCheck
+// sends to verify connectivity, e.g. a cheap introspection query. This
+// must be configured for HealthCheck to do anything useful.
+func WithHealthCheckRequest(request graphql.PostRequest) ClientOption {
+	return func(c *Client) {
+		c.healthCheckRequest = &request
+	}
+}
+
+// HealthCheck sends the configured health-check GraphQL document to
+// AppSync and reports any error, including a failure to sign or
+// authenticate the request. It's meant to be called periodically, e.g.
+// by NewHealthHandler, to verify both network reachability and that
+// credentials/signing are still working.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	if c.healthCheckRequest == nil {
+		return errors.New("appsync: HealthCheck requires a request configured via WithHealthCheckRequest")
+	}
+
+	header, err := c.createHeader(ctx, *c.healthCheckRequest)
+	if err != nil {
+		return err
+	}
+
+	handler := c.chain(func(_ context.Context, header http.Header, request graphql.PostRequest) (*graphql.Response, error) {
+		return c.graphQLAPI.Post(header, request)
+	})
+	_, err = c.postWithReAuth(ctx, *c.healthCheckRequest, handler, header)
+	return err
+}
@@ -0,0 +1,73 @@
+package appsync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// healthHandler periodically runs Client.HealthCheck and serves the
+// result of the most recent check.
+type healthHandler struct {
+	mu      sync.RWMutex
+	latency time.Duration
+	err     error
+}
+
+type healthBody struct {
+	OK        bool   `json:"ok"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// NewHealthHandler returns an http.Handler that serves 200 when the most
+// recent background HealthCheck against c succeeded, and 503 when it
+// failed, with a JSON body describing the latency and any error. The
+// check runs every interval on a background goroutine that never exits;
+// there's no way to stop it, so construct one per Client.
+func NewHealthHandler(c *Client, interval time.Duration) http.Handler {
+	h := &healthHandler{}
+	go h.run(c, interval)
+	return h
+}
+
+func (h *healthHandler) run(c *Client, interval time.Duration) {
+	h.check(c)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		h.check(c)
+	}
+}
+
+func (h *healthHandler) check(c *Client) {
+	start := time.Now()
+	err := c.HealthCheck(context.Background())
+	latency := time.Since(start)
+
+	h.mu.Lock()
+	h.latency = latency
+	h.err = err
+	h.mu.Unlock()
+}
+
+func (h *healthHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	h.mu.RLock()
+	body := healthBody{OK: h.err == nil, LatencyMs: h.latency.Milliseconds()}
+	if h.err != nil {
+		body.Error = h.err.Error()
+	}
+	h.mu.RUnlock()
+
+	status := http.StatusOK
+	if !body.OK {
+		status = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,57 @@
+package appsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHealthCheckRequiresConfiguredRequest(t *testing.T) {
+	c := NewClient(nil)
+
+	if err := c.HealthCheck(context.Background()); err == nil {
+		t.Fatal("HealthCheck() error = nil, want an error when no request is configured")
+	}
+}
+
+func TestHealthHandlerReportsStatus(t *testing.T) {
+	h := &healthHandler{latency: 5 * time.Millisecond}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var body healthBody
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding body: %v", err)
+	}
+	if !body.OK || body.LatencyMs != 5 {
+		t.Fatalf("body = %+v, want OK=true LatencyMs=5", body)
+	}
+}
+
+func TestHealthHandlerReportsFailure(t *testing.T) {
+	h := &healthHandler{err: errors.New("appsync: introspection query failed")}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+
+	var body healthBody
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding body: %v", err)
+	}
+	if body.OK || body.Error == "" {
+		t.Fatalf("body = %+v, want OK=false with an error message", body)
+	}
+}
@@ -0,0 +1,34 @@
+package appsync
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+// Handler performs a single signed AppSync POST request.
+type Handler func(ctx context.Context, header http.Header, request graphql.PostRequest) (*graphql.Response, error)
+
+// Middleware wraps a Handler with cross-cutting behavior, e.g. logging,
+// metrics, tracing, or retries. Middlewares are applied in the order
+// they're passed to WithMiddleware, so the first one wraps all the
+// others and runs first on the way in, last on the way out.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware appends mw to the chain wrapped around every Post and
+// PostAsync request.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, mw...)
+	}
+}
+
+// chain wraps terminal with the Client's configured middlewares.
+func (c *Client) chain(terminal Handler) Handler {
+	handler := terminal
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		handler = c.middlewares[i](handler)
+	}
+	return handler
+}
@@ -0,0 +1,55 @@
+package appsync
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+type authClaimsKey struct{}
+
+// AuthContextMiddleware decodes the claims out of the bearer JWT on the
+// outgoing request's Authorization header (without verifying its
+// signature) and stashes
+// them in the context passed to next, so downstream middlewares and
+// handlers can inspect the caller's identity.
+func AuthContextMiddleware() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, header http.Header, request graphql.PostRequest) (*graphql.Response, error) {
+			if claims, ok := decodeJWTClaims(header.Get("Authorization")); ok {
+				ctx = context.WithValue(ctx, authClaimsKey{}, claims)
+			}
+			return next(ctx, header, request)
+		}
+	}
+}
+
+// ClaimsFromContext returns the JWT claims stashed by AuthContextMiddleware,
+// if any were decoded for this request.
+func ClaimsFromContext(ctx context.Context) (map[string]interface{}, bool) {
+	claims, ok := ctx.Value(authClaimsKey{}).(map[string]interface{})
+	return claims, ok
+}
+
+func decodeJWTClaims(authHeader string) (map[string]interface{}, bool) {
+	token := strings.TrimPrefix(authHeader, "Bearer ")
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, false
+	}
+
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, false
+	}
+	return claims, true
+}
@@ -0,0 +1,59 @@
+package appsync
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+func TestAuthContextMiddlewareStashesDecodedClaims(t *testing.T) {
+	var gotClaims map[string]interface{}
+	var gotOK bool
+	terminal := Handler(func(ctx context.Context, _ http.Header, _ graphql.PostRequest) (*graphql.Response, error) {
+		gotClaims, gotOK = ClaimsFromContext(ctx)
+		return &graphql.Response{}, nil
+	})
+
+	header := http.Header{}
+	header.Set("Authorization", cognitoIDToken("id"))
+
+	handler := AuthContextMiddleware()(terminal)
+	if _, err := handler(context.Background(), header, graphql.PostRequest{}); err != nil {
+		t.Fatalf("handler() error = %v, want nil", err)
+	}
+
+	if !gotOK {
+		t.Fatal("ClaimsFromContext ok = false, want true for a well-formed JWT")
+	}
+	if gotClaims["token_use"] != "id" {
+		t.Fatalf("claims[token_use] = %v, want %q", gotClaims["token_use"], "id")
+	}
+}
+
+func TestAuthContextMiddlewareIgnoresMalformedToken(t *testing.T) {
+	var gotOK bool
+	terminal := Handler(func(ctx context.Context, _ http.Header, _ graphql.PostRequest) (*graphql.Response, error) {
+		_, gotOK = ClaimsFromContext(ctx)
+		return &graphql.Response{}, nil
+	})
+
+	header := http.Header{}
+	header.Set("Authorization", "not-a-jwt")
+
+	handler := AuthContextMiddleware()(terminal)
+	if _, err := handler(context.Background(), header, graphql.PostRequest{}); err != nil {
+		t.Fatalf("handler() error = %v, want nil", err)
+	}
+
+	if gotOK {
+		t.Fatal("ClaimsFromContext ok = true, want false when the Authorization header isn't a JWT")
+	}
+}
+
+func TestClaimsFromContextMissingWhenNotSet(t *testing.T) {
+	if _, ok := ClaimsFromContext(context.Background()); ok {
+		t.Fatal("ClaimsFromContext ok = true, want false when AuthContextMiddleware never ran")
+	}
+}
@@ -0,0 +1,50 @@
+package appsync
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+// NewBackoffMiddleware returns a Middleware that retries a request up to
+// maxAttempts times, with exponential backoff starting at baseDelay,
+// whenever the response contains an AppSync ThrottlingException.
+func NewBackoffMiddleware(maxAttempts int, baseDelay time.Duration) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, header http.Header, request graphql.PostRequest) (*graphql.Response, error) {
+			delay := baseDelay
+			var resp *graphql.Response
+			var err error
+
+			for attempt := 0; attempt <= maxAttempts; attempt++ {
+				resp, err = next(ctx, header, request)
+				if !isThrottled(resp) || attempt == maxAttempts {
+					return resp, err
+				}
+
+				select {
+				case <-time.After(delay):
+				case <-ctx.Done():
+					return resp, ctx.Err()
+				}
+				delay *= 2
+			}
+
+			return resp, err
+		}
+	}
+}
+
+func isThrottled(resp *graphql.Response) bool {
+	if resp == nil {
+		return false
+	}
+	for _, respErr := range resp.Errors {
+		if respErr.ErrorType == "ThrottlingException" {
+			return true
+		}
+	}
+	return false
+}
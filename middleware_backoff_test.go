@@ -0,0 +1,54 @@
+package appsync
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+func throttledResponse() *graphql.Response {
+	return &graphql.Response{Errors: []graphql.Error{{ErrorType: "ThrottlingException"}}}
+}
+
+func TestBackoffMiddlewareRetriesUntilSuccess(t *testing.T) {
+	calls := 0
+	terminal := Handler(func(_ context.Context, _ http.Header, _ graphql.PostRequest) (*graphql.Response, error) {
+		calls++
+		if calls < 3 {
+			return throttledResponse(), nil
+		}
+		return &graphql.Response{}, nil
+	})
+
+	handler := NewBackoffMiddleware(5, time.Millisecond)(terminal)
+	resp, err := handler(context.Background(), http.Header{}, graphql.PostRequest{})
+	if err != nil {
+		t.Fatalf("handler() error = %v, want nil", err)
+	}
+	if len(resp.Errors) != 0 {
+		t.Fatalf("handler() resp = %+v, want no errors", resp)
+	}
+	if calls != 3 {
+		t.Fatalf("handler invoked %d times, want 3", calls)
+	}
+}
+
+func TestBackoffMiddlewareStopsAtMaxAttempts(t *testing.T) {
+	calls := 0
+	terminal := Handler(func(_ context.Context, _ http.Header, _ graphql.PostRequest) (*graphql.Response, error) {
+		calls++
+		return throttledResponse(), nil
+	})
+
+	handler := NewBackoffMiddleware(2, time.Millisecond)(terminal)
+	resp, _ := handler(context.Background(), http.Header{}, graphql.PostRequest{})
+	if calls != 3 {
+		t.Fatalf("handler invoked %d times, want 3 (1 initial + 2 retries)", calls)
+	}
+	if len(resp.Errors) == 0 {
+		t.Fatal("handler() resp has no errors, want the last throttled response returned")
+	}
+}
@@ -0,0 +1,68 @@
+package appsync
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+// Span is the minimal span-lifecycle interface NewTracingMiddleware
+// needs, satisfied by an OpenTelemetry span (or any other tracer's),
+// without this package depending on OpenTelemetry directly.
+type Span interface {
+	SetError(err error)
+	End()
+}
+
+// Tracer starts a Span for a GraphQL operation. Wrap
+// go.opentelemetry.io/otel's Tracer.Start to use OpenTelemetry.
+type Tracer interface {
+	Start(ctx context.Context, operationName string) (context.Context, Span)
+}
+
+// NewTracingMiddleware returns a Middleware that starts a Span named
+// after the request's GraphQL operation for every request, and marks it
+// as an error if the request fails.
+func NewTracingMiddleware(tracer Tracer) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, header http.Header, request graphql.PostRequest) (*graphql.Response, error) {
+			ctx, span := tracer.Start(ctx, request.OperationName())
+			defer span.End()
+
+			resp, err := next(ctx, header, request)
+			if err != nil {
+				span.SetError(err)
+			}
+			return resp, err
+		}
+	}
+}
+
+// MetricsRecorder is the minimal interface NewMetricsMiddleware needs to
+// report per-operation request counts and latencies, satisfied by a thin
+// wrapper around prometheus.CounterVec/HistogramVec, without this
+// package depending on prometheus directly.
+type MetricsRecorder interface {
+	IncRequest(operationName string, success bool)
+	ObserveLatency(operationName string, d time.Duration)
+}
+
+// NewMetricsMiddleware returns a Middleware that records a request count
+// and latency observation, keyed by GraphQL operation name, for every
+// request.
+func NewMetricsMiddleware(recorder MetricsRecorder) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, header http.Header, request graphql.PostRequest) (*graphql.Response, error) {
+			start := time.Now()
+			resp, err := next(ctx, header, request)
+
+			operationName := request.OperationName()
+			recorder.ObserveLatency(operationName, time.Since(start))
+			recorder.IncRequest(operationName, err == nil)
+
+			return resp, err
+		}
+	}
+}
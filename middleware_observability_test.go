@@ -0,0 +1,121 @@
+package appsync
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+type fakeSpan struct {
+	err   error
+	ended bool
+}
+
+func (s *fakeSpan) SetError(err error) { s.err = err }
+func (s *fakeSpan) End()               { s.ended = true }
+
+type fakeTracer struct {
+	operationName string
+	span          *fakeSpan
+}
+
+func (t *fakeTracer) Start(ctx context.Context, operationName string) (context.Context, Span) {
+	t.operationName = operationName
+	t.span = &fakeSpan{}
+	return ctx, t.span
+}
+
+func TestTracingMiddlewareNamesSpanAndEndsIt(t *testing.T) {
+	tracer := &fakeTracer{}
+	terminal := Handler(func(_ context.Context, _ http.Header, _ graphql.PostRequest) (*graphql.Response, error) {
+		return &graphql.Response{}, nil
+	})
+
+	request := graphql.PostRequest{}
+	handler := NewTracingMiddleware(tracer)(terminal)
+	if _, err := handler(context.Background(), http.Header{}, request); err != nil {
+		t.Fatalf("handler() error = %v, want nil", err)
+	}
+
+	if tracer.span == nil || !tracer.span.ended {
+		t.Fatal("span was never started/ended")
+	}
+	if tracer.operationName != request.OperationName() {
+		t.Fatalf("span name = %q, want the request's operation name %q", tracer.operationName, request.OperationName())
+	}
+	if tracer.span.err != nil {
+		t.Fatalf("span.err = %v, want nil for a successful request", tracer.span.err)
+	}
+}
+
+func TestTracingMiddlewareMarksSpanOnError(t *testing.T) {
+	wantErr := errors.New("boom")
+	tracer := &fakeTracer{}
+	terminal := Handler(func(_ context.Context, _ http.Header, _ graphql.PostRequest) (*graphql.Response, error) {
+		return nil, wantErr
+	})
+
+	handler := NewTracingMiddleware(tracer)(terminal)
+	if _, err := handler(context.Background(), http.Header{}, graphql.PostRequest{}); err != wantErr {
+		t.Fatalf("handler() error = %v, want %v", err, wantErr)
+	}
+
+	if tracer.span.err != wantErr {
+		t.Fatalf("span.err = %v, want %v", tracer.span.err, wantErr)
+	}
+}
+
+type fakeMetricsRecorder struct {
+	incOperation  string
+	incSuccess    bool
+	observedDelay time.Duration
+}
+
+func (r *fakeMetricsRecorder) IncRequest(operationName string, success bool) {
+	r.incOperation = operationName
+	r.incSuccess = success
+}
+
+func (r *fakeMetricsRecorder) ObserveLatency(operationName string, d time.Duration) {
+	r.observedDelay = d
+}
+
+func TestMetricsMiddlewareRecordsSuccessAndLatency(t *testing.T) {
+	recorder := &fakeMetricsRecorder{}
+	terminal := Handler(func(_ context.Context, _ http.Header, _ graphql.PostRequest) (*graphql.Response, error) {
+		time.Sleep(time.Millisecond)
+		return &graphql.Response{}, nil
+	})
+
+	handler := NewMetricsMiddleware(recorder)(terminal)
+	if _, err := handler(context.Background(), http.Header{}, graphql.PostRequest{}); err != nil {
+		t.Fatalf("handler() error = %v, want nil", err)
+	}
+
+	if !recorder.incSuccess {
+		t.Fatal("IncRequest success = false, want true")
+	}
+	if recorder.observedDelay <= 0 {
+		t.Fatal("ObserveLatency recorded a non-positive duration")
+	}
+}
+
+func TestMetricsMiddlewareRecordsFailure(t *testing.T) {
+	recorder := &fakeMetricsRecorder{}
+	terminal := Handler(func(_ context.Context, _ http.Header, _ graphql.PostRequest) (*graphql.Response, error) {
+		return nil, errors.New("boom")
+	})
+
+	handler := NewMetricsMiddleware(recorder)(terminal)
+	if _, err := handler(context.Background(), http.Header{}, graphql.PostRequest{}); err == nil {
+		t.Fatal("handler() error = nil, want the terminal's error")
+	}
+
+	if recorder.incSuccess {
+		t.Fatal("IncRequest success = true, want false for a failed request")
+	}
+}
@@ -0,0 +1,83 @@
+package appsync
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+// ReAuther is an optional interface that an AuthTokenGetter may implement
+// to support transparent re-authentication. When GetAuthToken returns
+// ErrTokenExpired, or the server rejects the signed request as
+// unauthorized, the Client calls ReAuth to refresh the underlying
+// credentials before retrying the request (once by default; see
+// WithReAuthRetry).
+type ReAuther interface {
+	ReAuth(ctx context.Context) error
+}
+
+// WithReAuthRetry configures how many times the Client will call ReAuth
+// and retry a request after an unauthorized response, and how long it
+// waits between attempts. The default is a single attempt with no
+// backoff.
+func WithReAuthRetry(maxAttempts int, backoff time.Duration) ClientOption {
+	return func(c *Client) {
+		c.maxReAuthAttempts = maxAttempts
+		c.reAuthBackoff = backoff
+	}
+}
+
+// errReAuthNotSupported is returned internally by an Authorizer's ReAuth
+// method when the credentials it wraps don't support re-authentication,
+// so reAuth can tell "nothing to do" apart from a real failure.
+var errReAuthNotSupported = errors.New("appsync: re-auth not supported")
+
+// reAuth calls ReAuth on every configured Authorizer that supports it
+// (the legacy bearer AuthTokenGetter as well as any WithAuthorizer, e.g.
+// CognitoUserPoolAuthorizer, wrapping a ReAuther), returning false if
+// none of them support re-authentication. On success it resets the
+// Client's auth-age bookkeeping, so a RefreshPolicy doesn't keep
+// treating the (now freshly re-authed) token as stale.
+func (c *Client) reAuth(ctx context.Context) (bool, error) {
+	attempted := false
+	for _, authorizer := range c.authorizers {
+		reAuther, ok := authorizer.(ReAuther)
+		if !ok {
+			continue
+		}
+
+		if err := reAuther.ReAuth(ctx); err != nil {
+			if err == errReAuthNotSupported {
+				continue
+			}
+			return true, err
+		}
+		attempted = true
+	}
+
+	if !attempted {
+		return false, nil
+	}
+	c.resetAuthState()
+	return true, nil
+}
+
+// isUnauthorized reports whether err or resp indicates that the request
+// was rejected because the auth token is no longer valid.
+func isUnauthorized(resp *graphql.Response, err error) bool {
+	if err == ErrTokenExpired {
+		return true
+	}
+
+	if resp == nil {
+		return false
+	}
+	for _, respErr := range resp.Errors {
+		if respErr.ErrorType == "UnauthorizedException" {
+			return true
+		}
+	}
+	return false
+}
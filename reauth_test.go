@@ -0,0 +1,72 @@
+package appsync
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/mec07/appsync-client-go/graphql"
+)
+
+// cognitoIDToken builds a minimal unsigned JWT with the given token_use
+// claim, good enough for decodeJWTClaims to parse.
+func cognitoIDToken(tokenUse string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"token_use":"` + tokenUse + `"}`))
+	return header + "." + payload + ".sig"
+}
+
+type fakeCognitoTokenSource struct {
+	token      string
+	reAuthErr  error
+	reAuthCall int
+}
+
+func (f *fakeCognitoTokenSource) GetAuthToken() (string, error) {
+	return f.token, nil
+}
+
+func (f *fakeCognitoTokenSource) ReAuth(ctx context.Context) error {
+	f.reAuthCall++
+	return f.reAuthErr
+}
+
+func TestPostRetriesThroughCognitoAuthorizerReAuther(t *testing.T) {
+	ts := &fakeCognitoTokenSource{token: cognitoIDToken("id")}
+	graphQLAPI := &fakeGraphQLClient{
+		responses: []*graphql.Response{unauthorizedResponse(), {}},
+		errs:      []error{nil, nil},
+	}
+	c := NewClient(graphQLAPI, WithAuthorizer(CognitoUserPoolAuthorizer{TokenSource: ts}))
+
+	if _, err := c.Post(graphql.PostRequest{}); err != nil {
+		t.Fatalf("Post() error = %v, want nil", err)
+	}
+	if ts.reAuthCall != 1 {
+		t.Fatalf("ReAuth called %d times via CognitoUserPoolAuthorizer, want 1", ts.reAuthCall)
+	}
+	if graphQLAPI.calls != 2 {
+		t.Fatalf("Post dispatched %d times, want 2", graphQLAPI.calls)
+	}
+}
+
+func TestReAuthReportsUnsupportedWhenNoAuthorizerCanReAuth(t *testing.T) {
+	c := NewClient(nil, WithAuthorizer(APIKeyAuthorizer{APIKey: "key"}))
+
+	reAuthed, err := c.reAuth(context.Background())
+	if reAuthed || err != nil {
+		t.Fatalf("reAuth() = (%v, %v), want (false, nil) when nothing supports ReAuther", reAuthed, err)
+	}
+}
+
+func TestReAuthPropagatesUnderlyingError(t *testing.T) {
+	wantErr := errors.New("refresh failed")
+	ts := &fakeCognitoTokenSource{token: cognitoIDToken("id"), reAuthErr: wantErr}
+	c := NewClient(nil, WithAuthorizer(CognitoUserPoolAuthorizer{TokenSource: ts}))
+
+	reAuthed, err := c.reAuth(context.Background())
+	if !reAuthed || !errors.Is(err, wantErr) {
+		t.Fatalf("reAuth() = (%v, %v), want (true, %v)", reAuthed, err, wantErr)
+	}
+}
@@ -0,0 +1,128 @@
+package appsync
+
+import (
+	"context"
+	"time"
+)
+
+// RefreshPolicy controls how aggressively the Client rotates and expires
+// auth tokens, independently of whatever expiry the AuthTokenGetter
+// itself enforces. It's aimed at long-lived subscription connections
+// that need to rotate credentials without dropping the connection.
+type RefreshPolicy struct {
+	// DisableRotation, when true, keeps using the same cached
+	// AuthTokenGetter across UpdateAuth calls instead of swapping to the
+	// newly supplied one.
+	DisableRotation bool
+	// ReuseInterval is how long a token that's just been rotated out is
+	// still accepted, so requests that were already in flight when the
+	// rotation happened can still succeed.
+	ReuseInterval time.Duration
+	// AbsoluteLifetime is the maximum age of a token, regardless of how
+	// recently it's been used. Zero means no absolute limit.
+	AbsoluteLifetime time.Duration
+	// ValidIfNotUsedFor forces a re-auth if the token hasn't been used
+	// for this long. Zero means tokens never go stale from disuse.
+	ValidIfNotUsedFor time.Duration
+}
+
+// WithRefreshPolicy configures the Client's token rotation and lifetime
+// policy. Without this option the Client behaves as before: tokens are
+// used until the AuthTokenGetter itself reports a problem.
+//
+// AbsoluteLifetime and ValidIfNotUsedFor are only evaluated for auth
+// configured via WithAuthTokenGetter/UpdateAuth (the legacy bearer path);
+// they have no effect on auth configured purely via WithAuthorizer, e.g.
+// CognitoUserPoolAuthorizer.
+func WithRefreshPolicy(policy RefreshPolicy) ClientOption {
+	return func(c *Client) {
+		c.refreshPolicy = policy
+	}
+}
+
+// authState tracks, per cached AuthTokenGetter, when it was installed and
+// when it was last used to sign a request.
+type authState struct {
+	auth       AuthTokenGetter
+	issuedAt   time.Time
+	lastUsedAt time.Time
+}
+
+// resetAuthState marks the current auth as freshly issued and just used,
+// e.g. after a successful in-place ReAuth(). Without this, an
+// AbsoluteLifetime/ValidIfNotUsedFor policy that has tripped once would
+// keep forcing a re-auth on every subsequent request, since ReAuth
+// refreshes credentials in place rather than installing a new
+// AuthTokenGetter via UpdateAuth/rotateAuth.
+func (c *Client) resetAuthState() {
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	c.authState.issuedAt = now
+	c.authState.lastUsedAt = now
+}
+
+// noteAuthUse records that the current auth was just used, and reports
+// whether the refresh policy requires a re-auth before the token can be
+// used again.
+func (c *Client) noteAuthUse() bool {
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	policy := c.refreshPolicy
+
+	forceReAuth := false
+	if policy.AbsoluteLifetime > 0 && !c.authState.issuedAt.IsZero() && now.Sub(c.authState.issuedAt) > policy.AbsoluteLifetime {
+		forceReAuth = true
+	}
+	if policy.ValidIfNotUsedFor > 0 && !c.authState.lastUsedAt.IsZero() && now.Sub(c.authState.lastUsedAt) > policy.ValidIfNotUsedFor {
+		forceReAuth = true
+	}
+
+	c.authState.lastUsedAt = now
+	return forceReAuth
+}
+
+// rotateAuth installs newAuth as the current auth, honoring
+// RefreshPolicy.DisableRotation and keeping the previous auth usable for
+// ReuseInterval so requests already in flight don't fail.
+func (c *Client) rotateAuth(newAuth AuthTokenGetter) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.refreshPolicy.DisableRotation && c.auth != nil {
+		return
+	}
+
+	now := time.Now()
+	if c.auth != nil && c.refreshPolicy.ReuseInterval > 0 {
+		c.previousAuth = c.auth
+		c.previousValidUntil = now.Add(c.refreshPolicy.ReuseInterval)
+	}
+
+	c.auth = newAuth
+	c.authState = authState{auth: newAuth, issuedAt: now, lastUsedAt: now}
+}
+
+// fallbackAuth returns the previously rotated-out AuthTokenGetter, if
+// RefreshPolicy.ReuseInterval hasn't elapsed yet.
+func (c *Client) fallbackAuth() AuthTokenGetter {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.previousAuth == nil || time.Now().After(c.previousValidUntil) {
+		return nil
+	}
+	return c.previousAuth
+}
+
+// forceReAuth calls ReAuth on every configured Authorizer that supports
+// it, regardless of whether GetAuthToken reported a problem. It's used when
+// RefreshPolicy decides a token is stale even though the AuthTokenGetter
+// hasn't noticed yet.
+func (c *Client) forceReAuth(ctx context.Context) error {
+	_, err := c.reAuth(ctx)
+	return err
+}
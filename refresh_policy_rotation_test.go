@@ -0,0 +1,87 @@
+package appsync
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRotateAuthSwapsAuth(t *testing.T) {
+	c := NewClient(nil)
+	first := staticTokenGetter("first")
+	second := staticTokenGetter("second")
+
+	c.rotateAuth(first)
+	if c.getAuth() != AuthTokenGetter(first) {
+		t.Fatalf("getAuth() = %v, want %v", c.getAuth(), first)
+	}
+
+	c.rotateAuth(second)
+	if c.getAuth() != AuthTokenGetter(second) {
+		t.Fatalf("getAuth() = %v, want %v after rotation", c.getAuth(), second)
+	}
+}
+
+func TestRotateAuthDisableRotationMakesUpdateAuthANoOp(t *testing.T) {
+	c := NewClient(nil)
+	c.refreshPolicy = RefreshPolicy{DisableRotation: true}
+
+	first := staticTokenGetter("first")
+	c.UpdateAuth(first)
+	c.UpdateAuth(staticTokenGetter("second"))
+
+	if c.getAuth() != AuthTokenGetter(first) {
+		t.Fatalf("getAuth() = %v, want %v (DisableRotation should keep the first auth)", c.getAuth(), first)
+	}
+}
+
+func TestRotateAuthWithoutDisableRotationSwapsOnUpdateAuth(t *testing.T) {
+	c := NewClient(nil)
+
+	c.UpdateAuth(staticTokenGetter("first"))
+	c.UpdateAuth(staticTokenGetter("second"))
+
+	if c.getAuth() != AuthTokenGetter(staticTokenGetter("second")) {
+		t.Fatalf("getAuth() = %v, want %v", c.getAuth(), staticTokenGetter("second"))
+	}
+}
+
+func TestFallbackAuthServesPreviousTokenDuringReuseInterval(t *testing.T) {
+	c := NewClient(nil)
+	c.refreshPolicy = RefreshPolicy{ReuseInterval: time.Minute}
+
+	c.rotateAuth(staticTokenGetter("first"))
+	c.rotateAuth(staticTokenGetter("second"))
+
+	fallback := c.fallbackAuth()
+	if fallback == nil {
+		t.Fatal("fallbackAuth() = nil, want the previous auth while within ReuseInterval")
+	}
+	token, _ := fallback.GetAuthToken()
+	if token != "first" {
+		t.Fatalf("fallbackAuth() token = %q, want %q", token, "first")
+	}
+}
+
+func TestFallbackAuthExpiresAfterReuseInterval(t *testing.T) {
+	c := NewClient(nil)
+	c.refreshPolicy = RefreshPolicy{ReuseInterval: time.Millisecond}
+
+	c.rotateAuth(staticTokenGetter("first"))
+	c.rotateAuth(staticTokenGetter("second"))
+	time.Sleep(5 * time.Millisecond)
+
+	if fallback := c.fallbackAuth(); fallback != nil {
+		t.Fatalf("fallbackAuth() = %v, want nil once ReuseInterval has elapsed", fallback)
+	}
+}
+
+func TestFallbackAuthUnsetWithoutReuseInterval(t *testing.T) {
+	c := NewClient(nil)
+
+	c.rotateAuth(staticTokenGetter("first"))
+	c.rotateAuth(staticTokenGetter("second"))
+
+	if fallback := c.fallbackAuth(); fallback != nil {
+		t.Fatalf("fallbackAuth() = %v, want nil when ReuseInterval is unset", fallback)
+	}
+}
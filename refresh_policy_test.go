@@ -0,0 +1,45 @@
+package appsync
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNoteAuthUseForcesReAuthAfterAbsoluteLifetime(t *testing.T) {
+	c := NewClient(nil)
+	c.refreshPolicy = RefreshPolicy{AbsoluteLifetime: time.Millisecond}
+	c.authState.issuedAt = time.Now().Add(-time.Second)
+	c.authState.lastUsedAt = time.Now().Add(-time.Second)
+
+	if !c.noteAuthUse() {
+		t.Fatal("noteAuthUse() = false, want true once AbsoluteLifetime has elapsed")
+	}
+}
+
+func TestResetAuthStateStopsReAuthStorm(t *testing.T) {
+	c := NewClient(nil)
+	c.refreshPolicy = RefreshPolicy{AbsoluteLifetime: time.Millisecond}
+	c.authState.issuedAt = time.Now().Add(-time.Second)
+	c.authState.lastUsedAt = time.Now().Add(-time.Second)
+
+	if !c.noteAuthUse() {
+		t.Fatal("noteAuthUse() = false, want true before reset")
+	}
+
+	c.resetAuthState()
+
+	if c.noteAuthUse() {
+		t.Fatal("noteAuthUse() = true, want false right after resetAuthState")
+	}
+}
+
+func TestNoteAuthUseForcesReAuthAfterIdlePeriod(t *testing.T) {
+	c := NewClient(nil)
+	c.refreshPolicy = RefreshPolicy{ValidIfNotUsedFor: time.Millisecond}
+	c.authState.issuedAt = time.Now()
+	c.authState.lastUsedAt = time.Now().Add(-time.Second)
+
+	if !c.noteAuthUse() {
+		t.Fatal("noteAuthUse() = false, want true once ValidIfNotUsedFor has elapsed")
+	}
+}